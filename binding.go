@@ -0,0 +1,19 @@
+// Package tree_sitter_gobra is a compatibility shim for consumers still on
+// smacker/go-tree-sitter. New integrations should prefer the official
+// binding under bindings/go, which builds against
+// github.com/tree-sitter/go-tree-sitter and also exposes the highlight,
+// locals, tags, and injections queries.
+package tree_sitter_gobra
+
+// #cgo CFLAGS: -std=c11 -fPIC -fno-strict-aliasing
+// #include "src/parser.c"
+// #include "src/scanner.c"
+import "C"
+
+import "unsafe"
+
+// Language returns the tree-sitter language for this grammar, as an
+// unsafe.Pointer which must be wrapped by a tree-sitter binding before use.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_gobra())
+}
@@ -0,0 +1,32 @@
+package tree_sitter_gobra_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	tree_sitter "github.com/smacker/go-tree-sitter"
+	"github.com/tree-sitter/tree-sitter-gobra"
+)
+
+func TestCanLoadGrammar(t *testing.T) {
+	language := tree_sitter.NewLanguage(tree_sitter_gobra.Language())
+	if language == nil {
+		t.Errorf("Error loading Gobra grammar")
+	}
+
+	src, err := os.ReadFile("testdata/annotations.go")
+	if err != nil {
+		t.Fatalf("reading fixture: %v", err)
+	}
+
+	parser := tree_sitter.NewParser()
+	parser.SetLanguage(language)
+	tree, err := parser.ParseCtx(context.Background(), nil, src)
+	if err != nil {
+		t.Fatalf("parsing annotated fixture: %v", err)
+	}
+	if tree == nil || tree.RootNode() == nil {
+		t.Fatalf("expected a parse tree for testdata/annotations.go")
+	}
+}
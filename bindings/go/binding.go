@@ -0,0 +1,57 @@
+// Package tree_sitter_gobra is the official Go binding for the Gobra
+// tree-sitter grammar, built against github.com/tree-sitter/go-tree-sitter.
+//
+// Existing consumers of the smacker/go-tree-sitter API should keep using the
+// compatibility shim at the repository root instead.
+package tree_sitter_gobra
+
+// #cgo CFLAGS: -std=c11 -fPIC -fno-strict-aliasing
+// #include "../../src/parser.c"
+// #include "../../src/scanner.c"
+import "C"
+
+import (
+	_ "embed"
+	"unsafe"
+)
+
+// Language returns the tree-sitter language for this grammar, as an
+// unsafe.Pointer that sitter.NewLanguage accepts directly.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_gobra())
+}
+
+// The files below mirror ../../queries and ../../src/node-types.json:
+// go:embed cannot reach outside this module, so keep them in sync by hand
+// whenever the root queries change.
+
+//go:embed queries/highlights.scm
+var highlightsQuery []byte
+
+//go:embed queries/locals.scm
+var localsQuery []byte
+
+//go:embed queries/tags.scm
+var tagsQuery []byte
+
+//go:embed queries/injections.scm
+var injectionsQuery []byte
+
+//go:embed node-types.json
+var nodeTypes []byte
+
+// HighlightsQuery returns the contents of queries/highlights.scm.
+func HighlightsQuery() []byte { return highlightsQuery }
+
+// LocalsQuery returns the contents of queries/locals.scm.
+func LocalsQuery() []byte { return localsQuery }
+
+// TagsQuery returns the contents of queries/tags.scm.
+func TagsQuery() []byte { return tagsQuery }
+
+// InjectionsQuery returns the contents of queries/injections.scm.
+func InjectionsQuery() []byte { return injectionsQuery }
+
+// NodeTypes returns the grammar's node-types.json, describing the shape of
+// every named node the parser can produce.
+func NodeTypes() []byte { return nodeTypes }
@@ -1,15 +1,29 @@
 package tree_sitter_gobra_test
 
 import (
+	"context"
+	"os"
 	"testing"
 
-	tree_sitter "github.com/smacker/go-tree-sitter"
-	"github.com/tree-sitter/tree-sitter-gobra"
+	sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_gobra "github.com/tree-sitter/tree-sitter-gobra/bindings/go"
 )
 
 func TestCanLoadGrammar(t *testing.T) {
-	language := tree_sitter.NewLanguage(tree_sitter_gobra.Language())
+	language := sitter.NewLanguage(tree_sitter_gobra.Language())
 	if language == nil {
 		t.Errorf("Error loading Gobra grammar")
 	}
+
+	src, err := os.ReadFile("../../testdata/annotations.go")
+	if err != nil {
+		t.Fatalf("reading fixture: %v", err)
+	}
+
+	parser := sitter.NewParser()
+	parser.SetLanguage(language)
+	tree := parser.ParseCtx(context.Background(), src, nil)
+	if tree == nil || tree.RootNode() == nil {
+		t.Fatalf("expected a parse tree for testdata/annotations.go")
+	}
 }
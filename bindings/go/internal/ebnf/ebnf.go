@@ -0,0 +1,329 @@
+// Package ebnf is a minimal, vendored EBNF parser and verifier for the
+// dialect used by spec/gobra.ebnf (the same one documented by go/ebnf):
+// productions of the form `Name = expr .`, with `|` alternation, `[]`
+// optionality, `{}` repetition, `()` grouping, quoted terminal strings, and
+// `…` character ranges. It only implements the subset needed by
+// spec_test.go — Parse to build a Grammar and Verify to check it.
+package ebnf
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Expression is any right-hand side of a production.
+type Expression interface{ isExpression() }
+
+// Name references another production by name.
+type Name string
+
+// Token is a literal terminal string.
+type Token string
+
+// Range is a terminal range, e.g. "a" … "z".
+type Range struct{ Begin, End Token }
+
+// Sequence is a list of expressions that must match in order.
+type Sequence []Expression
+
+// Alternative is a list of expressions, any one of which may match.
+type Alternative []Expression
+
+// Option is an expression that may be omitted ([ expr ]).
+type Option struct{ Body Expression }
+
+// Repetition is an expression that may repeat zero or more times ({ expr }).
+type Repetition struct{ Body Expression }
+
+// Group is a parenthesized expression, kept only for round-tripping.
+type Group struct{ Body Expression }
+
+func (Name) isExpression()        {}
+func (Token) isExpression()       {}
+func (Range) isExpression()       {}
+func (Sequence) isExpression()    {}
+func (Alternative) isExpression() {}
+func (Option) isExpression()      {}
+func (Repetition) isExpression()  {}
+func (Group) isExpression()       {}
+
+// Production is a single `Name = Expression .` rule.
+type Production struct {
+	Name Name
+	Expr Expression
+}
+
+// Grammar is the set of productions in a spec, keyed by name.
+type Grammar map[string]*Production
+
+type parser struct {
+	s       *bufio.Scanner
+	tok     string
+	errs    []string
+	lineNum int
+}
+
+// Parse reads an EBNF grammar from r and returns the set of productions it
+// defines.
+func Parse(r io.Reader) (Grammar, error) {
+	p := &parser{s: bufio.NewScanner(r)}
+	p.s.Split(scanTokens)
+	p.next()
+
+	g := Grammar{}
+	for p.tok != "" {
+		name := p.tok
+		if !isIdentifier(name) {
+			return nil, fmt.Errorf("ebnf: expected production name, got %q", name)
+		}
+		p.next()
+		if p.tok != "=" {
+			return nil, fmt.Errorf("ebnf: expected '=' after %q, got %q", name, p.tok)
+		}
+		p.next()
+
+		expr, err := p.parseAlternative()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok != "." {
+			return nil, fmt.Errorf("ebnf: expected '.' to end production %q, got %q", name, p.tok)
+		}
+		p.next()
+
+		g[name] = &Production{Name: Name(name), Expr: expr}
+	}
+	return g, nil
+}
+
+func (p *parser) parseAlternative() (Expression, error) {
+	var alts Alternative
+	for {
+		seq, err := p.parseSequence()
+		if err != nil {
+			return nil, err
+		}
+		alts = append(alts, seq)
+		if p.tok != "|" {
+			break
+		}
+		p.next()
+	}
+	if len(alts) == 1 {
+		return alts[0], nil
+	}
+	return alts, nil
+}
+
+func (p *parser) parseSequence() (Expression, error) {
+	var seq Sequence
+	for {
+		term, ok, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		seq = append(seq, term)
+	}
+	if len(seq) == 0 {
+		return nil, fmt.Errorf("ebnf: expected an expression, got %q", p.tok)
+	}
+	if len(seq) == 1 {
+		return seq[0], nil
+	}
+	return seq, nil
+}
+
+func (p *parser) parseTerm() (Expression, bool, error) {
+	switch {
+	case p.tok == "":
+		return nil, false, nil
+	case p.tok == "|" || p.tok == "." || p.tok == ")" || p.tok == "]" || p.tok == "}":
+		return nil, false, nil
+	case p.tok == "(":
+		p.next()
+		body, err := p.parseAlternative()
+		if err != nil {
+			return nil, false, err
+		}
+		if p.tok != ")" {
+			return nil, false, fmt.Errorf("ebnf: expected ')', got %q", p.tok)
+		}
+		p.next()
+		return Group{Body: body}, true, nil
+	case p.tok == "[":
+		p.next()
+		body, err := p.parseAlternative()
+		if err != nil {
+			return nil, false, err
+		}
+		if p.tok != "]" {
+			return nil, false, fmt.Errorf("ebnf: expected ']', got %q", p.tok)
+		}
+		p.next()
+		return Option{Body: body}, true, nil
+	case p.tok == "{":
+		p.next()
+		body, err := p.parseAlternative()
+		if err != nil {
+			return nil, false, err
+		}
+		if p.tok != "}" {
+			return nil, false, fmt.Errorf("ebnf: expected '}', got %q", p.tok)
+		}
+		p.next()
+		return Repetition{Body: body}, true, nil
+	case isQuoted(p.tok):
+		tok := Token(unquote(p.tok))
+		p.next()
+		if p.tok == "…" {
+			p.next()
+			if !isQuoted(p.tok) {
+				return nil, false, fmt.Errorf("ebnf: expected terminal after '…', got %q", p.tok)
+			}
+			end := Token(unquote(p.tok))
+			p.next()
+			return Range{Begin: tok, End: end}, true, nil
+		}
+		return tok, true, nil
+	case isIdentifier(p.tok):
+		name := p.tok
+		p.next()
+		return Name(name), true, nil
+	default:
+		return nil, false, fmt.Errorf("ebnf: unexpected token %q", p.tok)
+	}
+}
+
+func (p *parser) next() {
+	if p.s.Scan() {
+		p.tok = p.s.Text()
+	} else {
+		p.tok = ""
+	}
+}
+
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsLetter(r)
+}
+
+func isQuoted(s string) bool {
+	return len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"'
+}
+
+func unquote(s string) string {
+	return strings.Trim(s, `"`)
+}
+
+// scanTokens is a bufio.SplitFunc that tokenizes the EBNF dialect: bare
+// identifiers, quoted strings, the punctuation `= | [ ] { } ( ) . …`, and
+// whitespace/comments are skipped.
+func scanTokens(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	start := 0
+	for start < len(data) {
+		r, width := utf8.DecodeRune(data[start:])
+		if unicode.IsSpace(r) {
+			start += width
+			continue
+		}
+		if r == '/' && start+1 < len(data) && data[start+1] == '/' {
+			i := strings.IndexByte(string(data[start:]), '\n')
+			if i < 0 {
+				if !atEOF {
+					return 0, nil, nil
+				}
+				return len(data), nil, nil
+			}
+			start += i + 1
+			continue
+		}
+		break
+	}
+	if start >= len(data) {
+		if atEOF {
+			return len(data), nil, nil
+		}
+		return start, nil, nil
+	}
+
+	r, width := utf8.DecodeRune(data[start:])
+	switch {
+	case r == '"':
+		for i := start + width; i < len(data); {
+			rr, w := utf8.DecodeRune(data[i:])
+			i += w
+			if rr == '"' {
+				return i, data[start:i], nil
+			}
+		}
+		if atEOF {
+			return 0, nil, fmt.Errorf("ebnf: unterminated string literal")
+		}
+		return start, nil, nil
+	case strings.ContainsRune(`=|[]{}().…`, r):
+		return start + width, data[start : start+width], nil
+	case unicode.IsLetter(r):
+		i := start + width
+		for i < len(data) {
+			rr, w := utf8.DecodeRune(data[i:])
+			if !unicode.IsLetter(rr) && !unicode.IsDigit(rr) {
+				break
+			}
+			i += w
+		}
+		return i, data[start:i], nil
+	default:
+		return start + width, data[start : start+width], nil
+	}
+}
+
+// Verify checks that start is defined in g and that every Name referenced,
+// directly or transitively, by a production is itself defined.
+func Verify(g Grammar, start string) error {
+	if _, ok := g[start]; !ok {
+		return fmt.Errorf("ebnf: start production %q is not defined", start)
+	}
+	var missing []string
+	for _, prod := range g {
+		walk(prod.Expr, func(name Name) {
+			if _, ok := g[string(name)]; !ok {
+				missing = append(missing, string(name))
+			}
+		})
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("ebnf: undefined production(s) referenced: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func walk(e Expression, visit func(Name)) {
+	switch e := e.(type) {
+	case Name:
+		visit(e)
+	case Sequence:
+		for _, sub := range e {
+			walk(sub, visit)
+		}
+	case Alternative:
+		for _, sub := range e {
+			walk(sub, visit)
+		}
+	case Option:
+		walk(e.Body, visit)
+	case Repetition:
+		walk(e.Body, visit)
+	case Group:
+		walk(e.Body, visit)
+	}
+}
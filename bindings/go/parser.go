@@ -0,0 +1,139 @@
+package tree_sitter_gobra
+
+import (
+	"context"
+	"fmt"
+
+	sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Kind categorizes a Diagnostic.
+type Kind int
+
+const (
+	// KindError marks an ERROR node: input the grammar could not fit
+	// anywhere in the tree.
+	KindError Kind = iota
+	// KindMissing marks a MISSING node: input the parser inserted to
+	// recover from a syntax error, e.g. a missing closing "@*/".
+	KindMissing
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindError:
+		return "error"
+	case KindMissing:
+		return "missing"
+	default:
+		return "unknown"
+	}
+}
+
+// Diagnostic describes a single ERROR or MISSING node recovered from a
+// parse, resolved to the source text it covers.
+type Diagnostic struct {
+	Range   sitter.Range
+	Kind    Kind
+	Snippet string
+	Hint    string
+}
+
+// Parser wraps a sitter.Parser pre-configured with the Gobra language, so
+// callers get structured diagnostics instead of having to walk the tree for
+// ERROR and MISSING nodes themselves.
+type Parser struct {
+	inner *sitter.Parser
+}
+
+// NewParser returns a Parser ready to parse Gobra-annotated Go source.
+func NewParser() *Parser {
+	p := sitter.NewParser()
+	if err := p.SetLanguage(sitter.NewLanguage(Language())); err != nil {
+		// The generated grammar and the go-tree-sitter runtime it is
+		// compiled against are versioned together, so a mismatch here
+		// is a build-time bug, not a condition callers can recover from.
+		panic(fmt.Sprintf("tree_sitter_gobra: %v", err))
+	}
+	return &Parser{inner: p}
+}
+
+// Close releases the native resources held by p. Callers should call it
+// once they are done with the Parser.
+func (p *Parser) Close() {
+	p.inner.Close()
+}
+
+// Parse parses src and returns the resulting tree along with any
+// diagnostics recovered from ERROR and MISSING nodes, in tree order. The
+// caller owns the returned tree and must call its Close method when done.
+func (p *Parser) Parse(ctx context.Context, src []byte) (*sitter.Tree, []Diagnostic, error) {
+	tree := p.inner.ParseCtx(ctx, src, nil)
+	if tree == nil {
+		return nil, nil, fmt.Errorf("tree_sitter_gobra: parser returned no tree")
+	}
+
+	var diags []Diagnostic
+	collectDiagnostics(tree.RootNode(), src, &diags)
+	return tree, diags, nil
+}
+
+func collectDiagnostics(n *sitter.Node, src []byte, diags *[]Diagnostic) {
+	switch {
+	case n.IsMissing():
+		*diags = append(*diags, Diagnostic{
+			Range:   n.Range(),
+			Kind:    KindMissing,
+			Snippet: string(src[n.StartByte():n.EndByte()]),
+			Hint:    fmt.Sprintf("expected %s here", n.Kind()),
+		})
+	case n.IsError():
+		*diags = append(*diags, Diagnostic{
+			Range:   n.Range(),
+			Kind:    KindError,
+			Snippet: string(src[n.StartByte():n.EndByte()]),
+			Hint:    "unexpected input",
+		})
+	}
+	for i := uint(0); i < n.ChildCount(); i++ {
+		collectDiagnostics(n.Child(i), src, diags)
+	}
+}
+
+// AnnotationSpan is the byte/point range of a single `//@` line or
+// `/*@ ... @*/` block.
+type AnnotationSpan struct {
+	Range sitter.Range
+	Block bool
+}
+
+// SplitAnnotations parses src and returns the range of every Gobra
+// annotation comment it contains, in source order.
+func SplitAnnotations(src []byte) []AnnotationSpan {
+	p := NewParser()
+	defer p.Close()
+
+	tree := p.inner.ParseCtx(context.Background(), src, nil)
+	if tree == nil {
+		return nil
+	}
+	defer tree.Close()
+
+	var spans []AnnotationSpan
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		switch n.Kind() {
+		case "annotation_line":
+			spans = append(spans, AnnotationSpan{Range: n.Range()})
+			return
+		case "annotation_block":
+			spans = append(spans, AnnotationSpan{Range: n.Range(), Block: true})
+			return
+		}
+		for i := uint(0); i < n.ChildCount(); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(tree.RootNode())
+	return spans
+}
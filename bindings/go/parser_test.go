@@ -0,0 +1,46 @@
+package tree_sitter_gobra_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	tree_sitter_gobra "github.com/tree-sitter/tree-sitter-gobra/bindings/go"
+)
+
+func TestParserCollectsDiagnostics(t *testing.T) {
+	src, err := os.ReadFile("../../testdata/annotations.go")
+	if err != nil {
+		t.Fatalf("reading fixture: %v", err)
+	}
+
+	p := tree_sitter_gobra.NewParser()
+	defer p.Close()
+
+	tree, diags, err := p.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	defer tree.Close()
+	if tree == nil || tree.RootNode() == nil {
+		t.Fatalf("expected a parse tree for testdata/annotations.go")
+	}
+	for _, d := range diags {
+		t.Errorf("unexpected diagnostic: %s %q (%s)", d.Kind, d.Snippet, d.Hint)
+	}
+}
+
+func TestSplitAnnotations(t *testing.T) {
+	src, err := os.ReadFile("../../testdata/annotations.go")
+	if err != nil {
+		t.Fatalf("reading fixture: %v", err)
+	}
+
+	spans := tree_sitter_gobra.SplitAnnotations(src)
+	if len(spans) != 3 {
+		t.Fatalf("got %d annotation spans, want 3", len(spans))
+	}
+	if spans[0].Block || spans[1].Block || !spans[2].Block {
+		t.Errorf("unexpected annotation kinds: %+v", spans)
+	}
+}
@@ -0,0 +1,35 @@
+package tree_sitter_gobra_test
+
+import (
+	"testing"
+
+	sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_gobra "github.com/tree-sitter/tree-sitter-gobra/bindings/go"
+)
+
+func TestQueriesCompile(t *testing.T) {
+	language := sitter.NewLanguage(tree_sitter_gobra.Language())
+
+	queries := map[string][]byte{
+		"highlights": tree_sitter_gobra.HighlightsQuery(),
+		"locals":     tree_sitter_gobra.LocalsQuery(),
+		"tags":       tree_sitter_gobra.TagsQuery(),
+		"injections": tree_sitter_gobra.InjectionsQuery(),
+	}
+
+	for name, source := range queries {
+		if len(source) == 0 {
+			t.Errorf("%s query is empty", name)
+			continue
+		}
+		if _, err := sitter.NewQuery(language, string(source)); err != nil {
+			t.Errorf("%s query failed to compile: %v", name, err)
+		}
+	}
+}
+
+func TestNodeTypesNotEmpty(t *testing.T) {
+	if len(tree_sitter_gobra.NodeTypes()) == 0 {
+		t.Error("expected NodeTypes() to return the grammar's node-types.json")
+	}
+}
@@ -0,0 +1,109 @@
+package tree_sitter_gobra_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode"
+
+	sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_gobra "github.com/tree-sitter/tree-sitter-gobra/bindings/go"
+	"github.com/tree-sitter/tree-sitter-gobra/bindings/go/internal/ebnf"
+)
+
+const (
+	specPath        = "../../spec/gobra.ebnf"
+	corpusDir       = "../../spec/corpus"
+	startProduction = "SourceFile"
+)
+
+// TestSpecGrammarIsWellFormed checks that spec/gobra.ebnf parses and that
+// every non-terminal it references is itself defined somewhere in the file.
+func TestSpecGrammarIsWellFormed(t *testing.T) {
+	f, err := os.Open(specPath)
+	if err != nil {
+		t.Fatalf("opening %s: %v", specPath, err)
+	}
+	defer f.Close()
+
+	g, err := ebnf.Parse(f)
+	if err != nil {
+		t.Fatalf("parsing EBNF spec: %v", err)
+	}
+	if err := ebnf.Verify(g, startProduction); err != nil {
+		t.Fatalf("verifying EBNF spec: %v", err)
+	}
+}
+
+// TestCorpusMatchesSpec parses every fixture under spec/corpus/ with the
+// tree-sitter grammar and checks the result against the EBNF spec: the root
+// node's type must match the EBNF start production, and the tree must
+// contain no ERROR or MISSING nodes.
+func TestCorpusMatchesSpec(t *testing.T) {
+	entries, err := os.ReadDir(corpusDir)
+	if err != nil {
+		t.Fatalf("reading %s: %v", corpusDir, err)
+	}
+
+	language := sitter.NewLanguage(tree_sitter_gobra.Language())
+	wantRoot := toSnakeCase(startProduction)
+
+	ran := 0
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".gobra") {
+			continue
+		}
+		ran++
+		entry := entry
+		t.Run(entry.Name(), func(t *testing.T) {
+			src, err := os.ReadFile(filepath.Join(corpusDir, entry.Name()))
+			if err != nil {
+				t.Fatalf("reading fixture: %v", err)
+			}
+
+			parser := sitter.NewParser()
+			parser.SetLanguage(language)
+			tree := parser.ParseCtx(context.Background(), src, nil)
+			root := tree.RootNode()
+
+			if root.Kind() != wantRoot {
+				t.Errorf("root node type = %q, want %q", root.Kind(), wantRoot)
+			}
+			assertNoErrorNodes(t, root)
+		})
+	}
+
+	if ran == 0 {
+		t.Fatalf("no .gobra fixtures found in %s; this test would otherwise pass vacuously", corpusDir)
+	}
+}
+
+func assertNoErrorNodes(t *testing.T, n *sitter.Node) {
+	t.Helper()
+	if n.IsError() || n.IsMissing() {
+		t.Errorf("found %s node at %s", n.Kind(), n.Range())
+		return
+	}
+	for i := uint(0); i < n.ChildCount(); i++ {
+		assertNoErrorNodes(t, n.Child(i))
+	}
+}
+
+// toSnakeCase converts an EBNF production name like "SourceFile" to the
+// tree-sitter node type "source_file" it is expected to correspond to.
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,15 @@
+package testdata
+
+//@ requires acc(x)
+//@ ensures acc(x)
+func Inc(x *int) {
+	*x++
+}
+
+/*@
+pure
+ensures acc(y)
+@*/
+func Get(y *int) int {
+	return *y
+}